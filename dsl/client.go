@@ -0,0 +1,18 @@
+package dsl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// doRequest issues req against ctx, so the caller can bound or cancel an
+// in-flight call to the mock service or Pact Broker (e.g. when a test is
+// torn down, or a CI job enforces a deadline).
+func doRequest(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error) {
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("calling %s: %w", req.URL, err)
+	}
+	return resp, nil
+}
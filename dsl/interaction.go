@@ -0,0 +1,59 @@
+package dsl
+
+// Request is the expectation of an HTTP request a consumer will make to
+// the provider, as set up against the mock service.
+type Request struct {
+	Method  string
+	Path    interface{}
+	Query   MapMatcher
+	Body    interface{}
+	Headers MapMatcher
+}
+
+// Response is how the mock service should respond when a Request is
+// matched.
+type Response struct {
+	Status  int
+	Body    interface{}
+	Headers MapMatcher
+}
+
+// Interaction sits within a Pact and describes a single request/response
+// pair the consumer expects to exchange with the provider.
+type Interaction struct {
+	providerState string
+	description   string
+	request       Request
+	response      Response
+
+	// matched records whether the mock service observed a request
+	// satisfying this interaction, used when verifying all expected
+	// interactions occurred.
+	matched bool
+}
+
+// Given specifies a provider state, used to set up fixture data on the
+// provider before this interaction is exercised.
+func (i *Interaction) Given(state string) *Interaction {
+	i.providerState = state
+	return i
+}
+
+// UponReceiving names the request this interaction expects to receive.
+func (i *Interaction) UponReceiving(description string) *Interaction {
+	i.description = description
+	return i
+}
+
+// WithRequest sets the expected request for this interaction.
+func (i *Interaction) WithRequest(request Request) *Interaction {
+	i.request = request
+	return i
+}
+
+// WillRespondWith sets the response the mock service returns once a
+// matching request is received.
+func (i *Interaction) WillRespondWith(response Response) *Interaction {
+	i.response = response
+	return i
+}
@@ -0,0 +1,105 @@
+package dsl
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Matcher is a generic matching rule applied to a request or response
+// value during Pact verification, in the same shape the Ruby/JS Pact
+// implementations use for their matching rule JSON.
+type Matcher map[string]interface{}
+
+// MapMatcher maps header or query parameter names to a matching value -
+// a Matcher, a String, or a plain string - so individual values can be
+// matched by type/regex rather than exact string equality.
+type MapMatcher map[string]interface{}
+
+// String is a plain string value, matched exactly rather than by type or
+// regex.
+type String string
+
+// Like specifies that the given content should be matched by type
+// (and, for objects/arrays, recursively), rather than by exact value.
+func Like(content interface{}) Matcher {
+	return Matcher{
+		"json_class": "Pact::SomethingLike",
+		"contents":   content,
+	}
+}
+
+// EachLike specifies that the given content should appear at least min
+// times in an array, with each element matched by type.
+func EachLike(content interface{}, min int) Matcher {
+	return Matcher{
+		"json_class": "Pact::ArrayLike",
+		"contents":   content,
+		"min":        min,
+	}
+}
+
+// Term specifies an exact value to use when generating a request/response
+// for the mock service, and a regular expression the real value must
+// match during provider verification.
+func Term(generate string, matcher string) Matcher {
+	return Matcher{
+		"json_class": "Pact::Term",
+		"data": map[string]interface{}{
+			"generate": generate,
+			"matcher": map[string]interface{}{
+				"json_class": "Regexp",
+				"s":          matcher,
+			},
+		},
+	}
+}
+
+// Match recursively traverses the given example struct/value and
+// generates a Like matcher for it - and for every field and slice
+// element within it - so a provider is only checked against the shape
+// and types of src and not its concrete example values.
+func Match(src interface{}) interface{} {
+	return matchValue(reflect.ValueOf(src))
+}
+
+// matchValue builds the Like/EachLike tree for a single reflected value.
+func matchValue(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem()).Elem()
+			continue
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := Matcher{}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			fields[jsonFieldName(field)] = matchValue(v.Field(i))
+		}
+		return Like(fields)
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return EachLike(matchValue(reflect.New(v.Type().Elem()).Elem()), 1)
+		}
+		return EachLike(matchValue(v.Index(0)), 1)
+	default:
+		return Like(v.Interface())
+	}
+}
+
+// jsonFieldName returns the name a struct field is serialised under,
+// honouring its json tag where present.
+func jsonFieldName(field reflect.StructField) string {
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	if tag == "" || tag == "-" {
+		return field.Name
+	}
+	return tag
+}
@@ -0,0 +1,261 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+
+	"github.com/pact-foundation/pact-go/types"
+)
+
+// Pact is the container structure to run Consumer Pact test cases.
+//
+// It manages the lifecycle of a mock service that stands in for the
+// Provider, and the interactions registered against it via
+// AddInteraction.
+type Pact struct {
+	Consumer                 string
+	Provider                 string
+	Host                     string
+	LogDir                   string
+	PactDir                  string
+	LogLevel                 string
+	DisableToolValidityCheck bool
+
+	// Server is the running mock service the code under test talks to.
+	// It is populated the first time AddInteraction is called.
+	Server *types.MockServer
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	mockService  *httptest.Server
+	httpClient   *http.Client
+}
+
+// AddInteraction registers a new Interaction with the mock service,
+// starting it on first use, and returns it so request/response
+// expectations can be chained off it.
+func (p *Pact) AddInteraction() *Interaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.start()
+
+	interaction := &Interaction{}
+	p.interactions = append(p.interactions, interaction)
+	return interaction
+}
+
+// start brings up the mock service backing this Pact, if it isn't
+// already running.
+func (p *Pact) start() {
+	if p.mockService != nil {
+		return
+	}
+
+	p.httpClient = http.DefaultClient
+	p.mockService = httptest.NewServer(http.HandlerFunc(p.handleMockRequest))
+	p.Server = &types.MockServer{
+		Port: p.mockService.Listener.Addr().(*net.TCPAddr).Port,
+	}
+}
+
+// handleMockRequest serves requests made by the consumer under test
+// against the interactions registered on this Pact, and answers the
+// internal verification check used by VerifyContext.
+func (p *Pact) handleMockRequest(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if r.Method == http.MethodGet && r.URL.Path == "/interactions/verification" {
+		p.writeVerificationResult(w)
+		return
+	}
+
+	for _, interaction := range p.interactions {
+		if !interaction.matchesRequest(r) {
+			continue
+		}
+
+		interaction.matched = true
+		for name, value := range interaction.response.Headers {
+			w.Header().Set(name, exampleValue(value))
+		}
+		w.WriteHeader(interaction.response.Status)
+		if interaction.response.Body != nil {
+			_ = json.NewEncoder(w).Encode(interaction.response.Body)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusInternalServerError)
+	fmt.Fprintf(w, "no interaction matched %s %s", r.Method, r.URL.Path)
+}
+
+// writeVerificationResult reports whether every registered interaction
+// was matched by a request from the consumer under test.
+func (p *Pact) writeVerificationResult(w http.ResponseWriter) {
+	for _, interaction := range p.interactions {
+		if !interaction.matched {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "expected interaction not received: %s", interaction.description)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Verify runs the integration test and checks it satisfied every
+// registered interaction. It delegates to VerifyContext with
+// context.Background() for backward compatibility.
+func (p *Pact) Verify(integrationTest func() error) error {
+	return p.VerifyContext(context.Background(), integrationTest)
+}
+
+// VerifyContext runs the integration test and checks it satisfied every
+// registered interaction. ctx bounds the whole call: it is checked
+// before the integration test starts, makes VerifyContext return
+// ctx.Err() early if it is cancelled while the test is still running
+// (e.g. a torn-down test or an expired CI deadline), and also bounds the
+// verification call made to the mock service once the test returns. An
+// integration test that is still running when ctx is cancelled is not
+// stopped; it carries on in its own goroutine.
+func (p *Pact) VerifyContext(ctx context.Context, integrationTest func() error) error {
+	defer p.resetInteractions()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- integrationTest()
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err = <-done:
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, p.mockService.URL+"/interactions/verification", nil)
+	if err != nil {
+		return fmt.Errorf("building interaction verification request: %w", err)
+	}
+
+	resp, err := doRequest(ctx, p.httpClient, req)
+	if err != nil {
+		return fmt.Errorf("verifying interactions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("not all interactions were matched (status %d)", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// resetInteractions clears the interactions registered against this Pact
+// so the next test starts from a clean mock service, mirroring the
+// daemon's behaviour of clearing interactions after each verification.
+func (p *Pact) resetInteractions() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.interactions = nil
+}
+
+// WritePact always returns nil: the in-process mock service writes no
+// pact file, to PactDir or anywhere else.
+func (p *Pact) WritePact() error {
+	// Writing the pact file is the responsibility of the mock service
+	// daemon in the full implementation; nothing further is required of
+	// the in-process mock used here.
+	return nil
+}
+
+// Teardown stops the mock service. It is safe to call multiple times.
+func (p *Pact) Teardown() *Pact {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.mockService != nil {
+		p.mockService.Close()
+		p.mockService = nil
+	}
+	return p
+}
+
+// matchesRequest reports whether r satisfies this interaction's method
+// and path expectation.
+func (i *Interaction) matchesRequest(r *http.Request) bool {
+	if i.request.Method != "" && i.request.Method != r.Method {
+		return false
+	}
+
+	if i.request.Path == nil {
+		return true
+	}
+
+	return pathMatches(i.request.Path, r.URL.Path)
+}
+
+// pathMatches reports whether actual satisfies the given Path
+// expectation: a Term matches via its regular expression, while a
+// String/plain string requires an exact match.
+func pathMatches(expected interface{}, actual string) bool {
+	if pattern, ok := termPattern(expected); ok {
+		matched, err := regexp.MatchString("^"+pattern+"$", actual)
+		return err == nil && matched
+	}
+	return exampleValue(expected) == actual
+}
+
+// termPattern returns the regular expression of a Term matcher, if value
+// is one.
+func termPattern(value interface{}) (string, bool) {
+	v, ok := value.(Matcher)
+	if !ok {
+		return "", false
+	}
+	data, ok := v["data"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	matcher, ok := data["matcher"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	pattern, ok := matcher["s"].(string)
+	return pattern, ok
+}
+
+// exampleValue extracts the concrete example value the mock service
+// should use from a matcher, a typed String, or a plain value.
+func exampleValue(value interface{}) string {
+	switch v := value.(type) {
+	case String:
+		return string(v)
+	case string:
+		return v
+	case Matcher:
+		if data, ok := v["data"].(map[string]interface{}); ok {
+			if generate, ok := data["generate"].(string); ok {
+				return generate
+			}
+		}
+		if contents, ok := v["contents"]; ok {
+			return fmt.Sprintf("%v", contents)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
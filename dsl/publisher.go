@@ -0,0 +1,69 @@
+package dsl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pact-foundation/pact-go/types"
+)
+
+// Publisher uploads one or more pact files to a Pact Broker on behalf of
+// a consumer version.
+type Publisher struct {
+	httpClient *http.Client
+}
+
+// Publish uploads the pacts described by request to the broker. It
+// delegates to PublishContext with context.Background() for backward
+// compatibility.
+func (p *Publisher) Publish(request types.PublishRequest) error {
+	return p.PublishContext(context.Background(), request)
+}
+
+// PublishContext uploads the pacts described by request to the broker,
+// aborting the upload if ctx is cancelled before the broker responds -
+// e.g. when an unreachable broker would otherwise hang a CI job.
+func (p *Publisher) PublishContext(ctx context.Context, request types.PublishRequest) error {
+	client := p.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, pactURL := range request.PactURLs {
+		if err := publishPact(ctx, client, request, pactURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishPact uploads a single pact file to the broker.
+func publishPact(ctx context.Context, client *http.Client, request types.PublishRequest, pactURL string) error {
+	url := fmt.Sprintf("%s/pacticipants/%s/versions/%s/pact-contents",
+		request.PactBroker, pactURL, request.ConsumerVersion)
+
+	req, err := http.NewRequest(http.MethodPut, url, nil)
+	if err != nil {
+		return fmt.Errorf("building publish request for %s: %w", pactURL, err)
+	}
+
+	if request.PactBrokerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+request.PactBrokerToken)
+	} else if request.BrokerUsername != "" {
+		req.SetBasicAuth(request.BrokerUsername, request.BrokerPassword)
+	}
+
+	resp, err := doRequest(ctx, client, req)
+	if err != nil {
+		return fmt.Errorf("publishing %s: %w", pactURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("publishing %s: broker responded with status %d", pactURL, resp.StatusCode)
+	}
+
+	return nil
+}
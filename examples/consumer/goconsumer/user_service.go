@@ -0,0 +1,73 @@
+package goconsumer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ex "github.com/pact-foundation/pact-go/examples/types"
+)
+
+// Address is the address embedded on a User.
+type Address struct {
+	Line1      string `json:"line1"`
+	City       string `json:"city"`
+	State      string `json:"state"`
+	PostalCode string `json:"postalcode"`
+}
+
+// User is the account record returned by the provider's login endpoint.
+type User struct {
+	Name     string   `json:"name"`
+	Lastname string   `json:"lastname"`
+	Type     string   `json:"type"`
+	Address  *Address `json:"address,omitempty"`
+}
+
+// loginResponse is the shape of a successful login response body.
+type loginResponse struct {
+	User User `json:"user"`
+}
+
+// Client talks to the provider's login endpoint.
+type Client struct {
+	Host string
+	user *User
+}
+
+// loginHandler forwards the username/password submitted in r to the
+// provider, and records the logged-in User on success.
+func (c *Client) loginHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := json.Marshal(ex.LoginRequest{
+		Username: r.PostFormValue("username"),
+		Password: r.PostFormValue("password"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	url := fmt.Sprintf("%s/users/login/10?foo=bar", c.Host)
+	resp, err := http.Post(url, "application/json; charset=utf-8", bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		c.user = nil
+		w.WriteHeader(resp.StatusCode)
+		return
+	}
+
+	var result loginResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	c.user = &result.User
+	w.WriteHeader(http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package goconsumer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -71,7 +72,13 @@ func TestMain(m *testing.M) {
 		// Publish the Pacts...
 		p := dsl.Publisher{}
 
-		err := p.Publish(types.PublishRequest{
+		// Give the broker a fixed window to accept the pact so a flaky/unreachable
+		// broker can't hang the build - PublishContext aborts the request once
+		// the deadline passes.
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := p.PublishContext(ctx, types.PublishRequest{
 			PactURLs:        []string{filepath.FromSlash(fmt.Sprintf("%s/billy-bobby.json", pactDir))},
 			PactBroker:      brokerHost,
 			ConsumerVersion: version,
@@ -174,6 +181,63 @@ func TestPactConsumerLoginHandler_UserExists(t *testing.T) {
 	}
 }
 
+func TestPactConsumerLoginHandler_UserExists_AbortsOnCancelledContext(t *testing.T) {
+	var testBillyExists = func() error {
+		client := Client{
+			Host: fmt.Sprintf("http://localhost:%d", pact.Server.Port),
+		}
+		client.loginHandler(rr, req)
+
+		// Expect User to be set on the Client
+		if client.user == nil {
+			return errors.New("Expected user not to be nil")
+		}
+
+		return nil
+	}
+
+	body :=
+		like(dsl.Matcher{
+			"user": dsl.Matcher{
+				"name": name,
+				"type": term("admin", "admin|user|guest"),
+			},
+		})
+
+	pact.
+		AddInteraction().
+		Given("User billy exists").
+		UponReceiving("A request to login with user 'billy' verified with a cancellable context").
+		WithRequest(request{
+			Method: "POST",
+			Path:   term("/users/login/1", "/users/login/[0-9]+"),
+			Query: dsl.MapMatcher{
+				"foo": term("bar", "[a-zA-Z]+"),
+			},
+			Body:    dsl.Match(loginRequest),
+			Headers: commonHeaders,
+		}).
+		WillRespondWith(dsl.Response{
+			Status: 200,
+			Body:   body,
+			Headers: dsl.MapMatcher{
+				"X-Api-Correlation-Id": dsl.Like("100"),
+				"Content-Type":         term("application/json; charset=utf-8", `application\/json`),
+			},
+		})
+
+	// Cancel the context before verifying, as if the test had been torn down
+	// or a CI job's deadline had expired, and confirm VerifyContext aborts
+	// instead of running the pending interaction check to completion.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := pact.VerifyContext(ctx, testBillyExists)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected VerifyContext to abort with context.Canceled, got: %v", err)
+	}
+}
+
 func TestPactConsumerLoginHandler_UserDoesNotExist(t *testing.T) {
 	var testBillyDoesNotExists = func() error {
 		client := Client{
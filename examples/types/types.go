@@ -0,0 +1,10 @@
+// Package types contains the request/response shapes shared by the
+// example consumer and provider services.
+package types
+
+// LoginRequest is the body the consumer sends to the provider's login
+// endpoint.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
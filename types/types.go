@@ -0,0 +1,36 @@
+// Package types contains the structures used by the dsl package to
+// communicate with the mock service and Pact Broker.
+package types
+
+// MockServer represents the mock HTTP service started for a Consumer
+// Pact test. Port is used by consumer code under test to direct requests
+// at the mock rather than a real provider.
+type MockServer struct {
+	Pid  int
+	Port int
+}
+
+// PublishRequest configures a request to publish one or more pact files
+// to a Pact Broker (or Pactflow) on behalf of a consumer version.
+type PublishRequest struct {
+	// PactURLs is the list of pact file paths or URLs to publish.
+	PactURLs []string
+
+	// PactBroker is the base URL of the Pact Broker to publish to.
+	PactBroker string
+
+	// PactBrokerToken is an API token to authenticate against the broker,
+	// used instead of BrokerUsername/BrokerPassword where supported.
+	PactBrokerToken string
+
+	// ConsumerVersion is the version of the consumer the pacts were
+	// generated against, usually the build or git SHA.
+	ConsumerVersion string
+
+	// Tags are applied to the published pacticipant version, e.g. the
+	// branch name or environment.
+	Tags []string
+
+	BrokerUsername string
+	BrokerPassword string
+}